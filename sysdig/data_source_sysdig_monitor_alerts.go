@@ -0,0 +1,230 @@
+package sysdig
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig/internal/client/monitor"
+)
+
+func dataSourceSysdigMonitorAlerts() *schema.Resource {
+	timeout := 5 * time.Minute
+
+	return &schema.Resource{
+		ReadContext: dataSourceSysdigMonitorAlertsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(timeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"severities": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"types": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"enabled_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"notification_channel_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"alerts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSysdigMonitorAlertsRead(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, err := i.(SysdigClients).sysdigMonitorClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	alerts, err := client.ListAlertsV2(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	alerts, err = filterAlertsV2(d, alerts)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := make([]int, len(alerts))
+	names := make([]string, len(alerts))
+	alertMaps := make([]map[string]interface{}, len(alerts))
+	for idx, alert := range alerts {
+		ids[idx] = alert.ID
+		names[idx] = alert.Name
+		alertMaps[idx] = map[string]interface{}{
+			"id":       alert.ID,
+			"name":     alert.Name,
+			"type":     alert.Type,
+			"severity": alert.Severity,
+			"enabled":  alert.Enabled,
+		}
+	}
+
+	_ = d.Set("ids", ids)
+	_ = d.Set("names", names)
+	_ = d.Set("alerts", alertMaps)
+
+	d.SetId(resource.UniqueId())
+
+	return nil
+}
+
+func filterAlertsV2(d *schema.ResourceData, alerts []monitor.AlertV2ListItem) ([]monitor.AlertV2ListItem, error) {
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		var err error
+		nameRegex, err = regexp.Compile(v.(string))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	severities := toIntSet(d.Get("severities").([]interface{}))
+	types := toStringSet(d.Get("types").([]interface{}))
+	enabledOnly := d.Get("enabled_only").(bool)
+	labels := toStringMap(d.Get("labels").(map[string]interface{}))
+	notificationChannelIDs := toIntSet(d.Get("notification_channel_ids").([]interface{}))
+
+	filtered := make([]monitor.AlertV2ListItem, 0, len(alerts))
+	for _, alert := range alerts {
+		if nameRegex != nil && !nameRegex.MatchString(alert.Name) {
+			continue
+		}
+		if len(severities) > 0 && !severities[alert.Severity] {
+			continue
+		}
+		if len(types) > 0 && !types[alert.Type] {
+			continue
+		}
+		if enabledOnly && !alert.Enabled {
+			continue
+		}
+		if !alertHasLabels(alert, labels) {
+			continue
+		}
+		if !alertHasNotificationChannels(alert, notificationChannelIDs) {
+			continue
+		}
+		filtered = append(filtered, alert)
+	}
+	return filtered, nil
+}
+
+// alertHasLabels reports whether alert carries every key/value pair in want.
+func alertHasLabels(alert monitor.AlertV2ListItem, want map[string]string) bool {
+	for k, v := range want {
+		if alert.Labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// alertHasNotificationChannels reports whether alert is wired to every
+// notification channel ID in want.
+func alertHasNotificationChannels(alert monitor.AlertV2ListItem, want map[int]bool) bool {
+	if len(want) == 0 {
+		return true
+	}
+
+	alertChannels := make(map[int]bool, len(alert.NotificationChannelIDs))
+	for _, id := range alert.NotificationChannelIDs {
+		alertChannels[id] = true
+	}
+
+	for id := range want {
+		if !alertChannels[id] {
+			return false
+		}
+	}
+	return true
+}
+
+func toIntSet(values []interface{}) map[int]bool {
+	set := make(map[int]bool, len(values))
+	for _, v := range values {
+		set[v.(int)] = true
+	}
+	return set
+}
+
+func toStringSet(values []interface{}) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v.(string)] = true
+	}
+	return set
+}
+
+func toStringMap(values map[string]interface{}) map[string]string {
+	m := make(map[string]string, len(values))
+	for k, v := range values {
+		m[k] = v.(string)
+	}
+	return m
+}
@@ -0,0 +1,80 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+)
+
+const alertsV2ListPath = "%s/api/monitor/v2/alertsv2"
+
+// AlertV2ListItem is the lightweight representation of an alert returned by
+// the list endpoint, as opposed to the full AlertV2Metric/AlertV2Prometheus
+// configs returned by the per-type Get calls.
+type AlertV2ListItem struct {
+	ID                     int               `json:"id"`
+	Name                   string            `json:"name"`
+	Type                   string            `json:"type"`
+	Severity               int               `json:"severity"`
+	Enabled                bool              `json:"enabled"`
+	Labels                 map[string]string `json:"labels,omitempty"`
+	NotificationChannelIDs []int             `json:"notificationChannelConfigIds,omitempty"`
+}
+
+type alertsV2ListPage struct {
+	Next string `json:"next,omitempty"`
+}
+
+type alertsV2ListResponse struct {
+	Alerts []AlertV2ListItem `json:"alerts"`
+	Page   alertsV2ListPage  `json:"page"`
+}
+
+// ListAlertsV2 fetches every Alert V2 the caller can see, with the fields
+// needed to filter by name regex, labels, notification channels, severity
+// and type. The list endpoint itself has no server-side filters beyond
+// pagination, so this follows the "page.next" cursor until the backend
+// stops returning one; filtering is then applied client-side by callers.
+func (client *Client) ListAlertsV2(ctx context.Context) ([]AlertV2ListItem, error) {
+	var alerts []AlertV2ListItem
+
+	cursor := ""
+	for {
+		response, err := client.requester.Request(ctx, http.MethodGet, client.alertsV2ListURL(cursor), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if response.StatusCode != http.StatusOK {
+			err = client.ErrorFromResponse(response)
+			response.Body.Close()
+			return nil, err
+		}
+
+		result, err := v2.Unmarshal[*alertsV2ListResponse](response.Body)
+		response.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		alerts = append(alerts, result.Alerts...)
+
+		if result.Page.Next == "" {
+			break
+		}
+		cursor = result.Page.Next
+	}
+
+	return alerts, nil
+}
+
+func (client *Client) alertsV2ListURL(cursor string) string {
+	base := fmt.Sprintf(alertsV2ListPath, client.config.url)
+	if cursor == "" {
+		return base
+	}
+	return base + "?cursor=" + url.QueryEscape(cursor)
+}
@@ -0,0 +1,123 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	v2 "github.com/draios/terraform-provider-sysdig/sysdig/internal/client/v2"
+)
+
+const (
+	alertsV2PrometheusPath = "%s/api/monitor/v2/alertsv2/prometheus"
+	alertV2PrometheusPath  = "%s/api/monitor/v2/alertsv2/prometheus/%d"
+)
+
+// AlertV2ConfigPrometheus holds the configuration for a PromQL-based Alert V2.
+//
+// Unlike the metric-threshold alert, the condition is expressed as a single
+// PromQL query evaluated over duration_seconds, so there is no separate
+// scope/segmentation, condition operator or threshold to model.
+type AlertV2ConfigPrometheus struct {
+	Query                string `json:"query"`
+	DurationSeconds      int    `json:"durationSeconds"`
+	KeepFiringForSeconds int    `json:"keepFiringForSeconds,omitempty"`
+	NoDataBehaviour      string `json:"noDataBehaviour,omitempty"`
+}
+
+type AlertV2Prometheus struct {
+	AlertV2Common
+	Config *AlertV2ConfigPrometheus `json:"config"`
+}
+
+type AlertV2PrometheusInterface interface {
+	CreateAlertV2Prometheus(ctx context.Context, alert AlertV2Prometheus) (AlertV2Prometheus, error)
+	GetAlertV2PrometheusById(ctx context.Context, id int) (AlertV2Prometheus, error)
+	UpdateAlertV2Prometheus(ctx context.Context, alert AlertV2Prometheus) (AlertV2Prometheus, error)
+	DeleteAlertV2Prometheus(ctx context.Context, id int) error
+}
+
+func (client *Client) CreateAlertV2Prometheus(ctx context.Context, alert AlertV2Prometheus) (AlertV2Prometheus, error) {
+	payload, err := v2.Marshal(alert)
+	if err != nil {
+		return AlertV2Prometheus{}, err
+	}
+
+	response, err := client.requester.Request(ctx, http.MethodPost, client.alertsV2PrometheusURL(), payload)
+	if err != nil {
+		return AlertV2Prometheus{}, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
+		return AlertV2Prometheus{}, client.ErrorFromResponse(response)
+	}
+
+	result, err := v2.Unmarshal[*AlertV2Prometheus](response.Body)
+	if err != nil {
+		return AlertV2Prometheus{}, err
+	}
+	return *result, nil
+}
+
+func (client *Client) GetAlertV2PrometheusById(ctx context.Context, id int) (AlertV2Prometheus, error) {
+	response, err := client.requester.Request(ctx, http.MethodGet, client.alertV2PrometheusURL(id), nil)
+	if err != nil {
+		return AlertV2Prometheus{}, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return AlertV2Prometheus{}, client.ErrorFromResponse(response)
+	}
+
+	result, err := v2.Unmarshal[*AlertV2Prometheus](response.Body)
+	if err != nil {
+		return AlertV2Prometheus{}, err
+	}
+	return *result, nil
+}
+
+func (client *Client) UpdateAlertV2Prometheus(ctx context.Context, alert AlertV2Prometheus) (AlertV2Prometheus, error) {
+	payload, err := v2.Marshal(alert)
+	if err != nil {
+		return AlertV2Prometheus{}, err
+	}
+
+	response, err := client.requester.Request(ctx, http.MethodPut, client.alertV2PrometheusURL(alert.ID), payload)
+	if err != nil {
+		return AlertV2Prometheus{}, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return AlertV2Prometheus{}, client.ErrorFromResponse(response)
+	}
+
+	result, err := v2.Unmarshal[*AlertV2Prometheus](response.Body)
+	if err != nil {
+		return AlertV2Prometheus{}, err
+	}
+	return *result, nil
+}
+
+func (client *Client) DeleteAlertV2Prometheus(ctx context.Context, id int) error {
+	response, err := client.requester.Request(ctx, http.MethodDelete, client.alertV2PrometheusURL(id), nil)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusNoContent && response.StatusCode != http.StatusOK {
+		return client.ErrorFromResponse(response)
+	}
+	return nil
+}
+
+func (client *Client) alertsV2PrometheusURL() string {
+	return fmt.Sprintf(alertsV2PrometheusPath, client.config.url)
+}
+
+func (client *Client) alertV2PrometheusURL(id int) string {
+	return fmt.Sprintf(alertV2PrometheusPath, client.config.url, id)
+}
@@ -0,0 +1,78 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Known CloudauthAccountSecure.Status values returned while onboarding
+// (role trust propagation, org discovery, component provisioning) is in
+// progress, and the terminal states it can settle into.
+const (
+	CloudauthAccountStatusPending      = "PENDING"
+	CloudauthAccountStatusProvisioning = "PROVISIONING"
+	CloudauthAccountStatusActive       = "ACTIVE"
+	CloudauthAccountStatusFailed       = "FAILED"
+)
+
+// AccountStatusWaiter polls a CloudauthAccountSecure until it reaches a
+// target status (or a terminal error status), the same pattern used by
+// RefreshFunc-based waiters in other Terraform providers: Create/Update
+// return as soon as the API accepts the request, but the account isn't
+// usable until onboarding finishes asynchronously.
+type AccountStatusWaiter struct {
+	Client  CloudauthAccountSecureInterface
+	Pending []string
+	Target  []string
+}
+
+// RefreshFunc returns a resource.StateRefreshFunc for the given account ID,
+// suitable for plugging into helper/resource.StateChangeConf.
+func (w *AccountStatusWaiter) RefreshFunc(ctx context.Context, accountID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		account, err := w.Client.GetCloudauthAccountSecure(ctx, accountID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if account.Status == CloudauthAccountStatusFailed {
+			return account, account.Status, fmt.Errorf("cloudauth account %s onboarding failed", accountID)
+		}
+
+		return account, account.Status, nil
+	}
+}
+
+// WaitForActive blocks until the account reaches CloudauthAccountStatusActive
+// or a terminal error status, bounded by timeout (typically sourced from the
+// resource's schema.ResourceTimeout via d.Timeout(schema.TimeoutCreate)).
+func (w *AccountStatusWaiter) WaitForActive(ctx context.Context, accountID string, timeout time.Duration) (*CloudauthAccountSecure, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    w.Pending,
+		Target:     w.Target,
+		Refresh:    w.RefreshFunc(ctx, accountID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	account, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error waiting for cloudauth account %s to become active: %w", accountID, err)
+	}
+
+	result := account.(*CloudauthAccountSecure)
+	return result, nil
+}
+
+// NewAccountStatusWaiter builds an AccountStatusWaiter that waits for an
+// account to leave PENDING/PROVISIONING and reach ACTIVE.
+func NewAccountStatusWaiter(client CloudauthAccountSecureInterface) *AccountStatusWaiter {
+	return &AccountStatusWaiter{
+		Client:  client,
+		Pending: []string{CloudauthAccountStatusPending, CloudauthAccountStatusProvisioning},
+		Target:  []string{CloudauthAccountStatusActive},
+	}
+}
@@ -3,6 +3,8 @@ package sysdig
 import (
 	"context"
 	"fmt"
+	"math"
+	"sort"
 	"strconv"
 	"time"
 
@@ -13,6 +15,27 @@ import (
 	"github.com/draios/terraform-provider-sysdig/sysdig/internal/client/monitor"
 )
 
+// alertV2ConditionSeverityOrder ranks the severities a condition block can
+// use, from most to least severe. The backend only models two threshold
+// levels (the main ConditionOperator/Threshold and the optional
+// WarningConditionOperator/WarningThreshold), so at most the two most severe
+// conditions supplied by the user can be represented.
+var alertV2ConditionSeverityOrder = map[string]int{
+	"critical": 0,
+	"high":     1,
+	"medium":   2,
+	"low":      3,
+	"info":     4,
+}
+
+var alertV2ConditionSeverities = []string{"critical", "high", "medium", "low", "info"}
+
+type alertV2Condition struct {
+	Severity  string
+	Op        string
+	Threshold float64
+}
+
 func resourceSysdigMonitorAlertV2Metric() *schema.Resource {
 
 	timeout := 5 * time.Minute
@@ -33,6 +56,75 @@ func resourceSysdigMonitorAlertV2Metric() *schema.Resource {
 			Delete: schema.DefaultTimeout(timeout),
 		},
 
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceSysdigMonitorAlertV2MetricV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceSysdigMonitorAlertV2MetricStateUpgradeV0,
+			},
+		},
+
+		Schema: createScopedSegmentedAlertV2Schema(createAlertV2Schema(map[string]*schema.Schema{
+			"condition": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"severity": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(alertV2ConditionSeverities, false),
+						},
+						"op": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{">", ">=", "<", "<=", "=", "!="}, false),
+						},
+						"threshold": {
+							Type:             schema.TypeFloat,
+							Required:         true,
+							DiffSuppressFunc: alertV2ThresholdDiffSuppress,
+						},
+					},
+				},
+			},
+			"threshold_epsilon": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Default:  0,
+			},
+			"metric": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"time_aggregation": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"avg", "timeAvg", "sum", "min", "max"}, false),
+			},
+			"group_aggregation": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"avg", "sum", "min", "max"}, false),
+			},
+			"no_data_behaviour": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "DO_NOTHING",
+				ValidateFunc: validation.StringInSlice([]string{"DO_NOTHING", "TRIGGER"}, false),
+			},
+		})),
+	}
+}
+
+// resourceSysdigMonitorAlertV2MetricV0 is the pre-"condition"-block schema
+// (flat op/threshold/warning_threshold), kept only so StateUpgraders can
+// decode state written by older provider versions.
+func resourceSysdigMonitorAlertV2MetricV0() *schema.Resource {
+	return &schema.Resource{
 		Schema: createScopedSegmentedAlertV2Schema(createAlertV2Schema(map[string]*schema.Schema{
 			"op": {
 				Type:         schema.TypeString,
@@ -71,6 +163,41 @@ func resourceSysdigMonitorAlertV2Metric() *schema.Resource {
 	}
 }
 
+// resourceSysdigMonitorAlertV2MetricStateUpgradeV0 migrates state written
+// with the flat op/threshold/warning_threshold fields into the "condition"
+// list, promoting the previous main threshold to severity "critical" and
+// the previous warning_threshold (if any) to severity "high" so existing
+// configs keep working unchanged after the upgrade.
+func resourceSysdigMonitorAlertV2MetricStateUpgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	op, _ := rawState["op"].(string)
+	threshold, _ := rawState["threshold"].(float64)
+
+	conditions := []interface{}{
+		map[string]interface{}{
+			"severity":  "critical",
+			"op":        op,
+			"threshold": threshold,
+		},
+	}
+
+	if warningThreshold, ok := rawState["warning_threshold"]; ok && warningThreshold != nil {
+		conditions = append(conditions, map[string]interface{}{
+			"severity":  "high",
+			"op":        op,
+			"threshold": warningThreshold,
+		})
+	}
+
+	rawState["condition"] = conditions
+	rawState["threshold_epsilon"] = 0.0
+
+	delete(rawState, "op")
+	delete(rawState, "threshold")
+	delete(rawState, "warning_threshold")
+
+	return rawState, nil
+}
+
 func resourceSysdigMonitorAlertV2MetricCreate(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
 	client, err := i.(SysdigClients).sysdigMonitorClient()
 	if err != nil {
@@ -181,17 +308,19 @@ func buildAlertV2MetricStruct(ctx context.Context, d *schema.ResourceData, clien
 		return nil, err
 	}
 
-	//ConditionOperator
-	config.ConditionOperator = d.Get("op").(string)
+	//Condition(s)
+	conditions, err := buildAlertV2Conditions(d)
+	if err != nil {
+		return nil, err
+	}
 
-	//threshold
-	config.Threshold = d.Get("threshold").(float64)
+	config.ConditionOperator = conditions[0].Op
+	config.Threshold = conditions[0].Threshold
 
-	//WarningThreshold
-	if warningThreshold, ok := d.GetOk("warning_threshold"); ok {
-		wt := warningThreshold.(float64)
+	if len(conditions) > 1 {
+		wt := conditions[1].Threshold
 		config.WarningThreshold = &wt
-		config.WarningConditionOperator = config.ConditionOperator
+		config.WarningConditionOperator = conditions[1].Op
 	}
 
 	//TimeAggregation
@@ -229,12 +358,9 @@ func updateAlertV2MetricState(d *schema.ResourceData, alert *monitor.AlertV2Metr
 		return err
 	}
 
-	_ = d.Set("op", alert.Config.ConditionOperator)
-
-	_ = d.Set("threshold", alert.Config.Threshold)
-
-	if alert.Config.WarningThreshold != nil {
-		_ = d.Set("warning_threshold", alert.Config.WarningThreshold)
+	err = d.Set("condition", flattenAlertV2Conditions(d, alert.Config))
+	if err != nil {
+		return err
 	}
 
 	_ = d.Set("time_aggregation", alert.Config.TimeAggregation)
@@ -246,4 +372,102 @@ func updateAlertV2MetricState(d *schema.ResourceData, alert *monitor.AlertV2Metr
 	_ = d.Set("no_data_behaviour", alert.Config.NoDataBehaviour)
 
 	return nil
-}
\ No newline at end of file
+}
+
+// buildAlertV2Conditions reads the "condition" list, orders it from most to
+// least severe and rejects configurations the backend can't represent (it
+// only has two threshold levels: the main one and an optional warning one).
+func buildAlertV2Conditions(d *schema.ResourceData) ([]alertV2Condition, error) {
+	rawConditions := d.Get("condition").([]interface{})
+
+	conditions := make([]alertV2Condition, 0, len(rawConditions))
+	seenSeverities := make(map[string]bool, len(rawConditions))
+	for _, rawCondition := range rawConditions {
+		c := rawCondition.(map[string]interface{})
+		severity := c["severity"].(string)
+		if seenSeverities[severity] {
+			return nil, fmt.Errorf("duplicate condition severity %q: each condition must use a distinct severity", severity)
+		}
+		seenSeverities[severity] = true
+
+		conditions = append(conditions, alertV2Condition{
+			Severity:  severity,
+			Op:        c["op"].(string),
+			Threshold: c["threshold"].(float64),
+		})
+	}
+
+	sort.Slice(conditions, func(i, j int) bool {
+		return alertV2ConditionSeverityOrder[conditions[i].Severity] < alertV2ConditionSeverityOrder[conditions[j].Severity]
+	})
+
+	if len(conditions) > 2 {
+		return nil, fmt.Errorf("alert v2 metric supports at most 2 condition severities (a main threshold and a warning threshold), got %d", len(conditions))
+	}
+
+	return conditions, nil
+}
+
+// flattenAlertV2Conditions rebuilds the "condition" list from the backend's
+// main/warning threshold fields. The backend itself has no notion of
+// severity, so the severities already present in state are reused (in
+// severity order) to avoid renaming the user's conditions on every read;
+// "critical"/"high" are only used as a fallback when state holds no
+// severity for that position (e.g. right after an import).
+func flattenAlertV2Conditions(d *schema.ResourceData, config *monitor.AlertV2ConfigMetric) []map[string]interface{} {
+	severities := make([]string, 0, 2)
+	for _, rawCondition := range d.Get("condition").([]interface{}) {
+		severities = append(severities, rawCondition.(map[string]interface{})["severity"].(string))
+	}
+	sort.Slice(severities, func(i, j int) bool {
+		return alertV2ConditionSeverityOrder[severities[i]] < alertV2ConditionSeverityOrder[severities[j]]
+	})
+
+	mainSeverity := "critical"
+	if len(severities) > 0 {
+		mainSeverity = severities[0]
+	}
+
+	conditions := []map[string]interface{}{
+		{
+			"severity":  mainSeverity,
+			"op":        config.ConditionOperator,
+			"threshold": config.Threshold,
+		},
+	}
+
+	if config.WarningThreshold != nil {
+		warningSeverity := "high"
+		if len(severities) > 1 {
+			warningSeverity = severities[1]
+		}
+		conditions = append(conditions, map[string]interface{}{
+			"severity":  warningSeverity,
+			"op":        config.WarningConditionOperator,
+			"threshold": *config.WarningThreshold,
+		})
+	}
+
+	return conditions
+}
+
+// alertV2ThresholdDiffSuppress suppresses diffs on condition thresholds
+// within threshold_epsilon of each other, since the backend can normalize
+// floats (e.g. rounding) and otherwise produce spurious plans.
+func alertV2ThresholdDiffSuppress(_, oldValue, newValue string, d *schema.ResourceData) bool {
+	epsilon := d.Get("threshold_epsilon").(float64)
+	if epsilon <= 0 {
+		return false
+	}
+
+	oldFloat, err := strconv.ParseFloat(oldValue, 64)
+	if err != nil {
+		return false
+	}
+	newFloat, err := strconv.ParseFloat(newValue, 64)
+	if err != nil {
+		return false
+	}
+
+	return math.Abs(oldFloat-newFloat) <= epsilon
+}
@@ -0,0 +1,63 @@
+package sysdig
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestResourceSysdigMonitorAlertV2MetricStateUpgradeV0(t *testing.T) {
+	tests := []struct {
+		name           string
+		rawState       map[string]interface{}
+		wantConditions []interface{}
+		wantEpsilon    float64
+	}{
+		{
+			name: "main threshold only",
+			rawState: map[string]interface{}{
+				"op":        ">",
+				"threshold": 90.0,
+			},
+			wantConditions: []interface{}{
+				map[string]interface{}{"severity": "critical", "op": ">", "threshold": 90.0},
+			},
+			wantEpsilon: 0.0,
+		},
+		{
+			name: "main and warning threshold",
+			rawState: map[string]interface{}{
+				"op":                ">",
+				"threshold":         90.0,
+				"warning_threshold": 70.0,
+			},
+			wantConditions: []interface{}{
+				map[string]interface{}{"severity": "critical", "op": ">", "threshold": 90.0},
+				map[string]interface{}{"severity": "high", "op": ">", "threshold": 70.0},
+			},
+			wantEpsilon: 0.0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resourceSysdigMonitorAlertV2MetricStateUpgradeV0(context.Background(), tt.rawState, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for _, removed := range []string{"op", "threshold", "warning_threshold"} {
+				if _, ok := got[removed]; ok {
+					t.Errorf("expected %q to be removed from state, got %v", removed, got[removed])
+				}
+			}
+
+			if !reflect.DeepEqual(got["condition"], tt.wantConditions) {
+				t.Errorf("condition = %#v, want %#v", got["condition"], tt.wantConditions)
+			}
+			if got["threshold_epsilon"] != tt.wantEpsilon {
+				t.Errorf("threshold_epsilon = %v, want %v", got["threshold_epsilon"], tt.wantEpsilon)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package sysdig_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig"
+)
+
+func TestAccAlertV2MetricCondition(t *testing.T) {
+	alertName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			if v := os.Getenv("SYSDIG_MONITOR_API_TOKEN"); v == "" {
+				t.Fatal("SYSDIG_MONITOR_API_TOKEN must be set for acceptance tests")
+			}
+		},
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"sysdig": func() (*schema.Provider, error) {
+				return sysdig.Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: alertV2MetricSingleCondition(alertName, 90),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"sysdig_monitor_alert_v2_metric.sample",
+						"condition.#",
+						"1",
+					),
+					resource.TestCheckResourceAttr(
+						"sysdig_monitor_alert_v2_metric.sample",
+						"condition.0.severity",
+						"critical",
+					),
+					resource.TestCheckResourceAttr(
+						"sysdig_monitor_alert_v2_metric.sample",
+						"condition.0.threshold",
+						"90",
+					),
+				),
+			},
+			{
+				Config: alertV2MetricTwoConditions(alertName, 90, 70),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"sysdig_monitor_alert_v2_metric.sample",
+						"condition.#",
+						"2",
+					),
+					resource.TestCheckResourceAttr(
+						"sysdig_monitor_alert_v2_metric.sample",
+						"threshold_epsilon",
+						"0.5",
+					),
+				),
+			},
+			{
+				ResourceName:      "sysdig_monitor_alert_v2_metric.sample",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func alertV2MetricSingleCondition(name string, threshold int) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_alert_v2_metric" "sample" {
+  name     = "%s"
+  severity = "critical"
+
+  condition {
+    severity  = "critical"
+    op        = ">"
+    threshold = %d
+  }
+
+  metric            = "cpu.used.percent"
+  time_aggregation  = "avg"
+  group_aggregation = "avg"
+}
+`, name, threshold)
+}
+
+func alertV2MetricTwoConditions(name string, criticalThreshold, highThreshold int) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_alert_v2_metric" "sample" {
+  name     = "%s"
+  severity = "critical"
+
+  condition {
+    severity  = "critical"
+    op        = ">"
+    threshold = %d
+  }
+
+  condition {
+    severity  = "high"
+    op        = ">"
+    threshold = %d
+  }
+
+  threshold_epsilon = 0.5
+
+  metric            = "cpu.used.percent"
+  time_aggregation  = "avg"
+  group_aggregation = "avg"
+}
+`, name, criticalThreshold, highThreshold)
+}
@@ -0,0 +1,196 @@
+package sysdig
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig/internal/client/monitor"
+)
+
+func resourceSysdigMonitorAlertV2Prometheus() *schema.Resource {
+
+	timeout := 5 * time.Minute
+
+	return &schema.Resource{
+		CreateContext: resourceSysdigMonitorAlertV2PrometheusCreate,
+		UpdateContext: resourceSysdigMonitorAlertV2PrometheusUpdate,
+		ReadContext:   resourceSysdigMonitorAlertV2PrometheusRead,
+		DeleteContext: resourceSysdigMonitorAlertV2PrometheusDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(timeout),
+			Update: schema.DefaultTimeout(timeout),
+			Read:   schema.DefaultTimeout(timeout),
+			Delete: schema.DefaultTimeout(timeout),
+		},
+
+		Schema: createAlertV2Schema(map[string]*schema.Schema{
+			"query": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"duration_seconds": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"keep_firing_for_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"no_data_behaviour": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "DO_NOTHING",
+				ValidateFunc: validation.StringInSlice([]string{"DO_NOTHING", "TRIGGER"}, false),
+			},
+		}),
+	}
+}
+
+func resourceSysdigMonitorAlertV2PrometheusCreate(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, err := i.(SysdigClients).sysdigMonitorClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	a, err := buildAlertV2PrometheusStruct(ctx, d, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	aCreated, err := client.CreateAlertV2Prometheus(ctx, *a)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(aCreated.ID))
+
+	err = updateAlertV2PrometheusState(d, &aCreated)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceSysdigMonitorAlertV2PrometheusRead(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, err := i.(SysdigClients).sysdigMonitorClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	a, err := client.GetAlertV2PrometheusById(ctx, id)
+
+	if err != nil {
+		d.SetId("")
+		return nil
+	}
+
+	err = updateAlertV2PrometheusState(d, &a)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceSysdigMonitorAlertV2PrometheusUpdate(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, err := i.(SysdigClients).sysdigMonitorClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	a, err := buildAlertV2PrometheusStruct(ctx, d, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	a.ID, _ = strconv.Atoi(d.Id())
+
+	aUpdated, err := client.UpdateAlertV2Prometheus(ctx, *a)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = updateAlertV2PrometheusState(d, &aUpdated)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceSysdigMonitorAlertV2PrometheusDelete(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, err := i.(SysdigClients).sysdigMonitorClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.DeleteAlertV2Prometheus(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func buildAlertV2PrometheusStruct(ctx context.Context, d *schema.ResourceData, client monitor.SysdigMonitorClient) (*monitor.AlertV2Prometheus, error) {
+	alertV2Common, err := buildAlertV2CommonStruct(ctx, d, client)
+	if err != nil {
+		return nil, err
+	}
+	alertV2Common.Type = monitor.AlertV2AlertType_Manual
+
+	config := &monitor.AlertV2ConfigPrometheus{}
+
+	config.Query = d.Get("query").(string)
+
+	config.DurationSeconds = d.Get("duration_seconds").(int)
+
+	config.KeepFiringForSeconds = d.Get("keep_firing_for_seconds").(int)
+
+	config.NoDataBehaviour = d.Get("no_data_behaviour").(string)
+
+	alert := &monitor.AlertV2Prometheus{
+		AlertV2Common: *alertV2Common,
+		Config:        config,
+	}
+	return alert, nil
+}
+
+func updateAlertV2PrometheusState(d *schema.ResourceData, alert *monitor.AlertV2Prometheus) error {
+	err := updateAlertV2CommonState(d, &alert.AlertV2Common)
+	if err != nil {
+		return err
+	}
+
+	_ = d.Set("query", alert.Config.Query)
+
+	_ = d.Set("duration_seconds", alert.Config.DurationSeconds)
+
+	_ = d.Set("keep_firing_for_seconds", alert.Config.KeepFiringForSeconds)
+
+	_ = d.Set("no_data_behaviour", alert.Config.NoDataBehaviour)
+
+	return nil
+}
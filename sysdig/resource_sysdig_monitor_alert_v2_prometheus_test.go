@@ -0,0 +1,76 @@
+package sysdig_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/draios/terraform-provider-sysdig/sysdig"
+)
+
+func TestAccAlertV2Prometheus(t *testing.T) {
+	alertName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			if v := os.Getenv("SYSDIG_MONITOR_API_TOKEN"); v == "" {
+				t.Fatal("SYSDIG_MONITOR_API_TOKEN must be set for acceptance tests")
+			}
+		},
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"sysdig": func() (*schema.Provider, error) {
+				return sysdig.Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: alertV2Prometheus(alertName, 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"sysdig_monitor_alert_v2_prometheus.sample",
+						"query",
+						`sum(rate(http_requests_total{job="api"}[5m])) > 0`,
+					),
+					resource.TestCheckResourceAttr(
+						"sysdig_monitor_alert_v2_prometheus.sample",
+						"duration_seconds",
+						"60",
+					),
+				),
+			},
+			{
+				Config: alertV2Prometheus(alertName, 120),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"sysdig_monitor_alert_v2_prometheus.sample",
+						"duration_seconds",
+						"120",
+					),
+				),
+			},
+			{
+				ResourceName:      "sysdig_monitor_alert_v2_prometheus.sample",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func alertV2Prometheus(name string, durationSeconds int) string {
+	return fmt.Sprintf(`
+resource "sysdig_monitor_alert_v2_prometheus" "sample" {
+  name     = "%s"
+  severity = "high"
+
+  query                   = "sum(rate(http_requests_total{job=\"api\"}[5m])) > 0"
+  duration_seconds        = %d
+  keep_firing_for_seconds = 0
+  no_data_behaviour       = "DO_NOTHING"
+}
+`, name, durationSeconds)
+}